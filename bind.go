@@ -0,0 +1,182 @@
+package lib_config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Bind populates the exported fields of target, which must be a pointer to a
+// struct, from environment variables described by `env`, `default`,
+// `required` and `description` struct tags, e.g.:
+//
+//	type Config struct {
+//		DBHost string `env:"DB_HOST" default:"localhost" description:"database host"`
+//		DB     struct {
+//			Port int `env:"PORT" required:"true"`
+//		} `env_prefix:"DB_"`
+//	}
+//
+// Nested structs are walked recursively and namespaced with `env_prefix`.
+// Bind reuses the same load/`.env` generation pipeline as SetupConfig, so a
+// struct tagged this way is a single source of truth for both the typed
+// getters and the generated `.env` / `.env.example` files. Every invalid or
+// missing field is aggregated into the returned error instead of exiting
+// the process.
+func Bind(target any) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("lib_config: Bind target must be a pointer to a struct")
+	}
+	structValue := v.Elem()
+
+	var configs []config
+	collectConfigs(structValue, "", &configs)
+	errs := applyConfigs(configs)
+
+	bindStruct(structValue, "", &errs)
+	if len(errs) > 0 {
+		return fmt.Errorf("lib_config: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// collectConfigs walks a struct's `env` tags and turns them into the same
+// config entries readConf produces from a YAML schema, so Bind can drive the
+// existing setup pipeline.
+func collectConfigs(v reflect.Value, prefix string, configs *[]config) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fv := v.Field(i)
+
+		if fv.Kind() == reflect.Struct {
+			nestedPrefix := prefix
+			if p, ok := field.Tag.Lookup("env_prefix"); ok {
+				nestedPrefix = prefix + p
+			}
+			collectConfigs(fv, nestedPrefix, configs)
+			continue
+		}
+
+		key, ok := field.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+		*configs = append(*configs, config{
+			Key:          prefix + key,
+			DefaultValue: field.Tag.Get("default"),
+			IsRequired:   field.Tag.Get("required") == "true",
+			Description:  field.Tag.Get("description"),
+		})
+	}
+}
+
+// bindStruct decodes the environment (already populated by setup) into the
+// struct's fields, appending one message per failure to errs.
+func bindStruct(v reflect.Value, prefix string, errs *[]string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fv := v.Field(i)
+
+		if fv.Kind() == reflect.Struct {
+			nestedPrefix := prefix
+			if p, ok := field.Tag.Lookup("env_prefix"); ok {
+				nestedPrefix = prefix + p
+			}
+			bindStruct(fv, nestedPrefix, errs)
+			continue
+		}
+
+		key, ok := field.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+		key = prefix + key
+
+		val, present := defaultLoader.Get(key)
+		if !present || val == "" {
+			val = field.Tag.Get("default")
+		}
+		if val == "" {
+			continue
+		}
+
+		if err := setField(fv, field, val); err != nil {
+			*errs = append(*errs, fmt.Sprintf("'%s': %s", key, err.Error()))
+		}
+	}
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// setField decodes val into fv according to its Go type, recognising the
+// same size suffixes as GetSize when the field carries a `type:"size"` tag.
+func setField(fv reflect.Value, field reflect.StructField, val string) error {
+	if fv.Type() == durationType {
+		d, err := time.ParseDuration(val)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", val)
+		}
+		fv.SetInt(int64(d))
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(val)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return fmt.Errorf("invalid bool %q", val)
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if field.Tag.Get("type") == "size" {
+			size, err := ParseSize(val)
+			if err != nil {
+				return err
+			}
+			fv.SetInt(size)
+			return nil
+		}
+		n, err := strconv.ParseInt(val, 10, fv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid int %q", val)
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(val, 10, fv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid uint %q", val)
+		}
+		fv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(val, fv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid float %q", val)
+		}
+		fv.SetFloat(f)
+	case reflect.Slice:
+		if fv.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported slice type %s", fv.Type())
+		}
+		parts := strings.Split(val, ",")
+		for i, p := range parts {
+			parts[i] = strings.TrimSpace(p)
+		}
+		fv.Set(reflect.ValueOf(parts))
+	default:
+		return fmt.Errorf("unsupported field type %s", fv.Type())
+	}
+	return nil
+}
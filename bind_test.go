@@ -0,0 +1,87 @@
+package lib_config
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// chdirTemp switches the test's working directory to a fresh t.TempDir()
+// for the duration of the test and restores it on cleanup, so exercising
+// Bind/applyConfigs (which writes .env/.env.example relative to cwd) never
+// touches the repo's real working directory.
+func chdirTemp(t *testing.T) {
+	t.Helper()
+	dir := t.TempDir()
+	orig, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("failed to chdir to temp dir: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := os.Chdir(orig); err != nil {
+			t.Fatalf("failed to restore working directory: %v", err)
+		}
+	})
+}
+
+func TestBindRequiredFieldMissingAggregatesError(t *testing.T) {
+	chdirTemp(t)
+	os.Unsetenv("CHUNK0_1_TEST_PORT")
+
+	var cfg struct {
+		Port int `env:"CHUNK0_1_TEST_PORT" required:"true"`
+	}
+
+	err := Bind(&cfg)
+	if err == nil {
+		t.Fatal("expected an error for a missing required field, got nil")
+	}
+	if !strings.Contains(err.Error(), "CHUNK0_1_TEST_PORT") {
+		t.Fatalf("expected error to mention the missing key, got: %v", err)
+	}
+}
+
+func TestBindAppliesDefaultsAndNestedPrefix(t *testing.T) {
+	chdirTemp(t)
+	os.Unsetenv("CHUNK0_1_TEST_HOST")
+	os.Unsetenv("CHUNK0_1_TEST_DB_PORT")
+	os.Setenv("CHUNK0_1_TEST_DB_PORT", "5432")
+	defer os.Unsetenv("CHUNK0_1_TEST_DB_PORT")
+
+	var cfg struct {
+		Host string `env:"CHUNK0_1_TEST_HOST" default:"localhost"`
+		DB   struct {
+			Port int `env:"PORT"`
+		} `env_prefix:"CHUNK0_1_TEST_DB_"`
+	}
+
+	if err := Bind(&cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Host != "localhost" {
+		t.Errorf("Host = %q, want %q", cfg.Host, "localhost")
+	}
+	if cfg.DB.Port != 5432 {
+		t.Errorf("DB.Port = %d, want 5432", cfg.DB.Port)
+	}
+}
+
+func TestBindDecodesSizeTaggedField(t *testing.T) {
+	chdirTemp(t)
+	os.Setenv("CHUNK0_1_TEST_MAX_BYTES", "2MiB")
+	defer os.Unsetenv("CHUNK0_1_TEST_MAX_BYTES")
+
+	var cfg struct {
+		MaxBytes int64 `env:"CHUNK0_1_TEST_MAX_BYTES" type:"size"`
+	}
+
+	if err := Bind(&cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := int64(2 * 1024 * 1024); cfg.MaxBytes != want {
+		t.Errorf("MaxBytes = %d, want %d", cfg.MaxBytes, want)
+	}
+}
@@ -26,10 +26,14 @@ func SetupConfig(configFile []byte) {
 }
 
 type config struct {
-	Key          string `json:"key" yaml:"key"`
-	DefaultValue string `json:"default" yaml:"default"`
-	IsRequired   bool   `json:"is_required" yaml:"is_required"`
-	Description  string `json:"description" yaml:"description"`
+	Key          string   `json:"key" yaml:"key"`
+	DefaultValue string   `json:"default" yaml:"default"`
+	IsRequired   bool     `json:"is_required" yaml:"is_required"`
+	Description  string   `json:"description" yaml:"description"`
+	Type         string   `json:"type" yaml:"type"`
+	Min          string   `json:"min" yaml:"min"`
+	Max          string   `json:"max" yaml:"max"`
+	Enum         []string `json:"enum" yaml:"enum"`
 }
 
 func readConf(file []byte) ([]config, error) {
@@ -46,8 +50,23 @@ func readConf(file []byte) ([]config, error) {
 	return data, nil
 }
 
+// setup is the process-terminating entry point used by SetupConfig: any
+// failure reported by applyConfigs (a required key missing, a failed write)
+// still exits the process, preserving the library's historical behavior.
 func setup(configs []config) {
-	failedSetupConf := false
+	if failures := applyConfigs(configs); len(failures) > 0 {
+		os.Exit(1)
+	}
+}
+
+// applyConfigs resolves every config against the default loader, sets the
+// process environment, and regenerates .env/.env.example, returning a
+// description of each failure instead of exiting so non-fatal callers
+// (Bind, Watch) can aggregate or log them and keep running.
+func applyConfigs(configs []config) []string {
+	setLoadedConfigs(configs)
+
+	var failures []string
 	dataENVFile := map[string][]string{}
 	dataENVExampleFile := map[string][]string{}
 
@@ -55,12 +74,13 @@ func setup(configs []config) {
 		slog.Info("Failed to load .env file, using environment variables")
 	}
 	for _, conf := range configs {
-		val := os.Getenv(conf.Key)
+		rawVal, _ := defaultLoader.Get(conf.Key)
+		val := expandValue(rawVal)
 		if val == "" {
-			val = conf.DefaultValue
+			val = expandValue(conf.DefaultValue)
 			if conf.IsRequired && val == "" {
 				slog.Error(fmt.Sprintf("Environment variable '%s' is required.", conf.Key), "description", conf.Description)
-				failedSetupConf = true
+				failures = append(failures, fmt.Sprintf("environment variable '%s' is required", conf.Key))
 			} else if !conf.IsRequired {
 				if val == "" {
 					slog.Info(fmt.Sprintf("Environment variable '%s' is not set.", conf.Key), "description", conf.Description)
@@ -72,7 +92,7 @@ func setup(configs []config) {
 		if val != "" {
 			if err := os.Setenv(conf.Key, val); err != nil {
 				slog.Error("Failed to set environment variable.", "env_key", conf.Key, "value", val, "err", err)
-				failedSetupConf = true
+				failures = append(failures, fmt.Sprintf("failed to set environment variable '%s': %s", conf.Key, err.Error()))
 			} else {
 				slog.Info("Success to set environment variable.", "env_key", conf.Key, "value", val, "description", conf.Description)
 			}
@@ -115,16 +135,20 @@ func setup(configs []config) {
 	}
 
 	if err := _genFileENV(".env", dataENVFile); err != nil {
-		os.Exit(1)
+		failures = append(failures, fmt.Sprintf("failed to write .env: %s", err.Error()))
 	}
 
 	if err := _genFileENV(".env.example", dataENVExampleFile); err != nil {
-		os.Exit(1)
+		failures = append(failures, fmt.Sprintf("failed to write .env.example: %s", err.Error()))
 	}
 
-	if failedSetupConf {
-		os.Exit(1)
+	if GetBool("GENERATE_CONFIG_DOCS") {
+		if err := writeConfigDocs(); err != nil {
+			slog.Error("Failed to generate CONFIG.md", "error", err.Error())
+		}
 	}
+
+	return failures
 }
 
 func _addSpace(field string, level int) string {
@@ -210,13 +234,16 @@ func _createFile(filename string, data []byte) (*os.File, error) {
 	return f, nil
 }
 
+// Set assigns key in the default loader's highest-priority provider, so it
+// takes precedence over process env, .env, and any file or remote provider.
 func Set(key, value string) {
+	globalSetProvider.set(key, value)
 	_ = os.Setenv(key, value)
 	slog.Info(fmt.Sprintf("Setting '%s' to '%s'", key, value))
 }
 
 func GetInt(key string) int {
-	val, ok := os.LookupEnv(key)
+	val, ok := defaultLoader.Get(key)
 	if !ok {
 		return 0
 	}
@@ -232,7 +259,7 @@ func GetInt64(key string) int64 {
 }
 
 func GetString(key string) string {
-	val, ok := os.LookupEnv(key)
+	val, ok := defaultLoader.Get(key)
 	if !ok {
 		return ""
 	}
@@ -240,7 +267,7 @@ func GetString(key string) string {
 }
 
 func GetBool(key string) bool {
-	val, ok := os.LookupEnv(key)
+	val, ok := defaultLoader.Get(key)
 	if !ok {
 		return false
 	}
@@ -248,7 +275,7 @@ func GetBool(key string) bool {
 }
 
 func GetListString(key string) []string {
-	val, ok := os.LookupEnv(key)
+	val, ok := defaultLoader.Get(key)
 	if !ok {
 		return []string{}
 	}
@@ -256,7 +283,7 @@ func GetListString(key string) []string {
 }
 
 func GetDuration(key string) time.Duration {
-	val, ok := os.LookupEnv(key)
+	val, ok := defaultLoader.Get(key)
 	if !ok {
 		return 0
 	}
@@ -267,53 +294,3 @@ func GetDuration(key string) time.Duration {
 	return duration
 }
 
-func GetSize(key string) int64 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return 0
-	}
-
-	fmt.Println(val)
-
-	value := "0"
-	format := "b"
-
-	formatMap := []string{"b", "kb", "mb", "gb", "tb", "pb"}
-
-	val = strings.ToLower(strings.TrimSpace(val))
-	fmt.Println(val)
-	if val[len(val)-1] == 'b' {
-		switch val[len(val)-2:] {
-		case "kb":
-			value = val[:len(val)-2]
-			format = "kb"
-		case "mb":
-			value = val[:len(val)-2]
-			format = "mb"
-		case "gb":
-			value = val[:len(val)-2]
-			format = "gb"
-		case "tb":
-			value = val[:len(val)-2]
-			format = "tb"
-		default:
-			value = val
-		}
-	}
-
-	fmt.Println(value, format)
-
-	valN, err := strconv.ParseInt(value, 10, 64)
-	if err != nil {
-		return 0
-	}
-
-	for i, v := range formatMap {
-		if v == format {
-			fmt.Println(valN, "x", i+1, "x", 1024)
-			return valN * (int64(i) + 1) * 1024
-		}
-	}
-
-	return 0
-}
@@ -0,0 +1,25 @@
+package lib_config
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestLoadedConfigsConcurrentAccess exercises setLoadedConfigs/
+// getLoadedConfigs the way Watch's poll loop and GenerateDocs use them
+// concurrently; run with -race to confirm no data race is reported.
+func TestLoadedConfigsConcurrentAccess(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			setLoadedConfigs([]config{{Key: "CHUNK0_4_TEST_KEY"}})
+		}()
+		go func() {
+			defer wg.Done()
+			_ = getLoadedConfigs()
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,206 @@
+package lib_config
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// loadedConfigs holds the schema most recently passed through setup (via
+// SetupConfig or Bind), so GenerateDocs can describe it without callers
+// threading the schema through again. It's guarded by loadedConfigsMu since
+// Watch's poll loop writes it from a background goroutine while
+// GenerateDocs (or another setup/Bind call) may read it concurrently; always
+// go through setLoadedConfigs/getLoadedConfigs rather than touching it
+// directly.
+var (
+	loadedConfigsMu sync.RWMutex
+	loadedConfigs   []config
+)
+
+func setLoadedConfigs(configs []config) {
+	loadedConfigsMu.Lock()
+	defer loadedConfigsMu.Unlock()
+	loadedConfigs = configs
+}
+
+func getLoadedConfigs() []config {
+	loadedConfigsMu.RLock()
+	defer loadedConfigsMu.RUnlock()
+	return loadedConfigs
+}
+
+// GenerateDocs writes a reference of every key in the schema loaded by the
+// last SetupConfig/Bind call to w, in the requested format: "markdown",
+// "html" or "json-schema". Wire it behind a flag (or the
+// GENERATE_CONFIG_DOCS env var, which setup already honors to regenerate
+// CONFIG.md) so CI keeps it alongside .env.example.
+func GenerateDocs(format string, w io.Writer) error {
+	switch strings.ToLower(format) {
+	case "markdown", "md":
+		return generateMarkdownDocs(w)
+	case "html":
+		return generateHTMLDocs(w)
+	case "json-schema":
+		return generateJSONSchemaDocs(w)
+	default:
+		return fmt.Errorf("lib_config: unsupported doc format %q", format)
+	}
+}
+
+// writeConfigDocs regenerates CONFIG.md from the currently loaded schema.
+func writeConfigDocs() error {
+	f, err := os.Create("CONFIG.md")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return GenerateDocs("markdown", f)
+}
+
+// groupedConfigs buckets the loaded schema by the same prefix used to group
+// the generated .env file: strings.Split(conf.Key, "_")[0].
+func groupedConfigs() map[string][]config {
+	groups := map[string][]config{}
+	for _, c := range getLoadedConfigs() {
+		prefix := strings.Split(c.Key, "_")[0]
+		groups[prefix] = append(groups[prefix], c)
+	}
+	return groups
+}
+
+func sortedGroupNames(groups map[string][]config) []string {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func constraintText(c config) string {
+	var parts []string
+	if c.Min != "" {
+		parts = append(parts, fmt.Sprintf("min: %s", c.Min))
+	}
+	if c.Max != "" {
+		parts = append(parts, fmt.Sprintf("max: %s", c.Max))
+	}
+	if len(c.Enum) > 0 {
+		parts = append(parts, fmt.Sprintf("one of: %s", strings.Join(c.Enum, ", ")))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func generateMarkdownDocs(w io.Writer) error {
+	groups := groupedConfigs()
+	for _, name := range sortedGroupNames(groups) {
+		if _, err := fmt.Fprintf(w, "## %s\n\n", name); err != nil {
+			return err
+		}
+		for _, c := range groups[name] {
+			if err := writeMarkdownEntry(w, c); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeMarkdownEntry(w io.Writer, c config) error {
+	if _, err := fmt.Fprintf(w, "- `%s`", c.Key); err != nil {
+		return err
+	}
+	if c.Type != "" {
+		if _, err := fmt.Fprintf(w, " _%s_", c.Type); err != nil {
+			return err
+		}
+	}
+	if c.IsRequired {
+		if _, err := fmt.Fprint(w, " **(required)**"); err != nil {
+			return err
+		}
+	}
+	if c.DefaultValue != "" {
+		if _, err := fmt.Fprintf(w, " — default `%s`", c.DefaultValue); err != nil {
+			return err
+		}
+	}
+	if c.Description != "" {
+		if _, err := fmt.Fprintf(w, ": %s", c.Description); err != nil {
+			return err
+		}
+	}
+	if constraint := constraintText(c); constraint != "" {
+		if _, err := fmt.Fprintf(w, " (%s)", constraint); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+func generateHTMLDocs(w io.Writer) error {
+	groups := groupedConfigs()
+	if _, err := fmt.Fprintln(w, "<table>"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w, "<tr><th>Key</th><th>Type</th><th>Default</th><th>Required</th><th>Description</th><th>Constraints</th></tr>"); err != nil {
+		return err
+	}
+	for _, name := range sortedGroupNames(groups) {
+		if _, err := fmt.Fprintf(w, "<tr><th colspan=\"6\">%s</th></tr>\n", html.EscapeString(name)); err != nil {
+			return err
+		}
+		for _, c := range groups[name] {
+			if _, err := fmt.Fprintf(w, "<tr><td>%s</td><td>%s</td><td>%s</td><td>%t</td><td>%s</td><td>%s</td></tr>\n",
+				html.EscapeString(c.Key), html.EscapeString(c.Type), html.EscapeString(c.DefaultValue),
+				c.IsRequired, html.EscapeString(c.Description), html.EscapeString(constraintText(c))); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := fmt.Fprintln(w, "</table>")
+	return err
+}
+
+type jsonSchemaProperty struct {
+	Type        string   `json:"type,omitempty"`
+	Default     string   `json:"default,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Group       string   `json:"group,omitempty"`
+	Required    bool     `json:"required"`
+	Minimum     string   `json:"minimum,omitempty"`
+	Maximum     string   `json:"maximum,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+}
+
+func generateJSONSchemaDocs(w io.Writer) error {
+	properties := map[string]jsonSchemaProperty{}
+	for _, c := range getLoadedConfigs() {
+		properties[c.Key] = jsonSchemaProperty{
+			Type:        c.Type,
+			Default:     c.DefaultValue,
+			Description: c.Description,
+			Group:       strings.Split(c.Key, "_")[0],
+			Required:    c.IsRequired,
+			Minimum:     c.Min,
+			Maximum:     c.Max,
+			Enum:        c.Enum,
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(map[string]any{
+		"type":       "object",
+		"properties": properties,
+	})
+}
@@ -0,0 +1,33 @@
+package lib_config
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestGenerateHTMLDocsEscapesDescription(t *testing.T) {
+	setLoadedConfigs([]config{
+		{Key: "CHUNK0_4_TEST_KEY", Description: `<script>alert("x")</script>`},
+	})
+
+	var buf bytes.Buffer
+	if err := GenerateDocs("html", &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	if strings.Contains(out, "<script>") {
+		t.Fatalf("expected description to be HTML-escaped, got: %s", out)
+	}
+	if !strings.Contains(out, "&lt;script&gt;") {
+		t.Fatalf("expected escaped script tag in output, got: %s", out)
+	}
+}
+
+func TestGenerateDocsUnsupportedFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := GenerateDocs("toml", &buf); err == nil {
+		t.Fatal("expected an error for an unsupported doc format")
+	}
+}
@@ -0,0 +1,55 @@
+package lib_config
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+var (
+	envRefPattern     = regexp.MustCompile(`\$ENV_([A-Za-z_][A-Za-z0-9_]*)`)
+	envDefaultPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*):-([^}]*)\}`)
+)
+
+// maxExpandDepth bounds recursive expansion so a reference cycle (e.g.
+// A -> $ENV_B, B -> $ENV_A) cannot loop forever.
+const maxExpandDepth = 10
+
+// dollarEscape is a sentinel substituted for a literal "$$" before expansion
+// so an escaped dollar is never mistaken for the start of a reference.
+const dollarEscape = "\x00lib_config:$\x00"
+
+// expandValue resolves $ENV_FOO and ${FOO:-fallback} references in val
+// against the current environment, mirroring the indirection Beego's config
+// package offers so a default can point at another environment variable
+// instead of hard-coding a secret. Expansion is recursive, guarded against
+// cycles by maxExpandDepth, and a literal "$$" is preserved as a single "$".
+func expandValue(val string) string {
+	val = strings.ReplaceAll(val, "$$", dollarEscape)
+	for depth := 0; depth < maxExpandDepth; depth++ {
+		next := expandOnce(val)
+		if next == val {
+			break
+		}
+		val = next
+	}
+	return strings.ReplaceAll(val, dollarEscape, "$")
+}
+
+func expandOnce(val string) string {
+	val = envDefaultPattern.ReplaceAllStringFunc(val, func(match string) string {
+		groups := envDefaultPattern.FindStringSubmatch(match)
+		name, fallback := groups[1], groups[2]
+		if v, ok := os.LookupEnv(name); ok && v != "" {
+			return v
+		}
+		return fallback
+	})
+
+	val = envRefPattern.ReplaceAllStringFunc(val, func(match string) string {
+		name := envRefPattern.FindStringSubmatch(match)[1]
+		return os.Getenv(name)
+	})
+
+	return val
+}
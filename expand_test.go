@@ -0,0 +1,57 @@
+package lib_config
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestExpandValueEnvReference(t *testing.T) {
+	os.Setenv("CHUNK0_2_TEST_SECRET", "s3cr3t")
+	defer os.Unsetenv("CHUNK0_2_TEST_SECRET")
+
+	got := expandValue("$ENV_CHUNK0_2_TEST_SECRET")
+	if got != "s3cr3t" {
+		t.Errorf("expandValue = %q, want %q", got, "s3cr3t")
+	}
+}
+
+func TestExpandValueDefaultFallback(t *testing.T) {
+	os.Unsetenv("CHUNK0_2_TEST_MISSING")
+
+	got := expandValue("${CHUNK0_2_TEST_MISSING:-fallback}")
+	if got != "fallback" {
+		t.Errorf("expandValue = %q, want %q", got, "fallback")
+	}
+}
+
+func TestExpandValueDefaultPreferEnv(t *testing.T) {
+	os.Setenv("CHUNK0_2_TEST_PRESENT", "actual")
+	defer os.Unsetenv("CHUNK0_2_TEST_PRESENT")
+
+	got := expandValue("${CHUNK0_2_TEST_PRESENT:-fallback}")
+	if got != "actual" {
+		t.Errorf("expandValue = %q, want %q", got, "actual")
+	}
+}
+
+func TestExpandValueEscapedDollarIsLiteral(t *testing.T) {
+	got := expandValue("price is $$5")
+	if got != "price is $5" {
+		t.Errorf("expandValue = %q, want %q", got, "price is $5")
+	}
+}
+
+func TestExpandValueRecursiveWithCycleGuard(t *testing.T) {
+	os.Setenv("CHUNK0_2_TEST_A", "$ENV_CHUNK0_2_TEST_A")
+	defer os.Unsetenv("CHUNK0_2_TEST_A")
+
+	done := make(chan string, 1)
+	go func() { done <- expandValue("$ENV_CHUNK0_2_TEST_A") }()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expandValue did not return, cycle guard failed")
+	}
+}
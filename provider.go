@@ -0,0 +1,257 @@
+package lib_config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/joho/godotenv"
+)
+
+// Provider is a source of configuration values consulted by a Loader in
+// priority order.
+type Provider interface {
+	// Get returns the value for key and whether it was found.
+	Get(key string) (string, bool)
+}
+
+// ProviderFactory builds a remote Provider (Consul, Vault, etcd, ...) from a
+// connection URL, e.g. "consul://localhost:8500".
+type ProviderFactory func(url string) (Provider, error)
+
+var (
+	providerFactoriesMu sync.RWMutex
+	providerFactories   = map[string]ProviderFactory{}
+)
+
+// RegisterProvider makes a remote Provider implementation available to
+// AddRemoteProvider under name. Call it from an init() in the package that
+// implements the provider.
+func RegisterProvider(name string, factory ProviderFactory) {
+	providerFactoriesMu.Lock()
+	defer providerFactoriesMu.Unlock()
+	providerFactories[name] = factory
+}
+
+// Loader merges a prioritized list of Providers into a single view: the
+// first provider that has a key wins. Providers are consulted in the order
+// they were added, so the highest-priority source should be added first.
+type Loader struct {
+	mu        sync.RWMutex
+	providers []Provider
+}
+
+// NewLoader builds a Loader from providers in priority order (highest
+// priority first).
+func NewLoader(providers ...Provider) *Loader {
+	return &Loader{providers: providers}
+}
+
+// Add appends a provider to the end of the priority list.
+func (l *Loader) Add(p Provider) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.providers = append(l.providers, p)
+}
+
+// Get consults each provider in priority order and returns the first match.
+func (l *Loader) Get(key string) (string, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, p := range l.providers {
+		if v, ok := p.Get(key); ok {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// setProvider backs Set: values assigned explicitly in code always win over
+// every other source.
+type setProvider struct {
+	mu     sync.RWMutex
+	values map[string]string
+}
+
+func newSetProvider() *setProvider {
+	return &setProvider{values: map[string]string{}}
+}
+
+func (p *setProvider) Get(key string) (string, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	v, ok := p.values[key]
+	return v, ok
+}
+
+func (p *setProvider) set(key, value string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.values[key] = value
+}
+
+// envProvider reads the process environment.
+type envProvider struct{}
+
+func (envProvider) Get(key string) (string, bool) {
+	return os.LookupEnv(key)
+}
+
+// dotenvProvider reads a .env file without mutating the process
+// environment, so it can be layered below envProvider. The parsed file is
+// cached and only re-read when one of its files' mtimes advances, so a hot
+// path calling GetString/GetBool/etc. doesn't hit disk on every lookup;
+// Watch's poll loop observes the same file and drives the cache forward as
+// soon as it changes.
+type dotenvProvider struct {
+	filenames []string
+
+	mu      sync.RWMutex
+	modTime time.Time
+	values  map[string]string
+}
+
+func newDotenvProvider(filenames ...string) *dotenvProvider {
+	if len(filenames) == 0 {
+		filenames = []string{".env"}
+	}
+	return &dotenvProvider{filenames: filenames}
+}
+
+func (p *dotenvProvider) Get(key string) (string, bool) {
+	p.refresh()
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	v, ok := p.values[key]
+	return v, ok
+}
+
+// refresh reloads the cached values if any watched file is newer than the
+// last load, or if nothing has been loaded yet.
+func (p *dotenvProvider) refresh() {
+	var latest time.Time
+	for _, filename := range p.filenames {
+		info, err := os.Stat(filename)
+		if err != nil {
+			continue
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.values != nil && !latest.After(p.modTime) {
+		return
+	}
+
+	values, err := godotenv.Read(p.filenames...)
+	if err != nil {
+		if p.values == nil {
+			p.values = map[string]string{}
+		}
+		return
+	}
+	p.values = values
+	p.modTime = latest
+}
+
+// fileProvider reads a flat or nested YAML/JSON config file into a
+// key/value view, joining nested keys with "_" and upper-casing them so
+// "db: {host: ...}" resolves the same key as the env var "DB_HOST".
+type fileProvider struct {
+	values map[string]string
+}
+
+// NewFileProvider loads a YAML or JSON configuration file (selected by its
+// extension) into a Provider. TOML is not implemented: this module has no
+// TOML dependency today, and adding one just for this is out of scope. A
+// TOML (or any other format) file can still be layered in via a Provider
+// implementation registered through RegisterProvider/AddRemoteProvider.
+func NewFileProvider(path string) (Provider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := map[string]any{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("lib_config: unsupported config file extension %q", filepath.Ext(path))
+	}
+
+	values := map[string]string{}
+	flattenInto("", raw, values)
+	return &fileProvider{values: values}, nil
+}
+
+func flattenInto(prefix string, raw map[string]any, out map[string]string) {
+	for k, v := range raw {
+		key := strings.ToUpper(k)
+		if prefix != "" {
+			key = prefix + "_" + key
+		}
+		if nested, ok := v.(map[string]any); ok {
+			flattenInto(key, nested, out)
+			continue
+		}
+		out[key] = fmt.Sprintf("%v", v)
+	}
+}
+
+func (p *fileProvider) Get(key string) (string, bool) {
+	v, ok := p.values[key]
+	return v, ok
+}
+
+// AddRemoteProvider connects to a remote provider registered under name via
+// RegisterProvider and layers it into the default loader below the file and
+// .env sources, so process env still wins over it.
+func AddRemoteProvider(name, url string) error {
+	providerFactoriesMu.RLock()
+	factory, ok := providerFactories[name]
+	providerFactoriesMu.RUnlock()
+	if !ok {
+		return fmt.Errorf("lib_config: no provider registered for %q", name)
+	}
+	provider, err := factory(url)
+	if err != nil {
+		return err
+	}
+	defaultLoader.Add(provider)
+	return nil
+}
+
+// AddFileProvider layers a YAML/JSON config file into the default loader,
+// below the .env file and process env but above any remote provider added
+// afterwards.
+func AddFileProvider(path string) error {
+	provider, err := NewFileProvider(path)
+	if err != nil {
+		return err
+	}
+	defaultLoader.Add(provider)
+	return nil
+}
+
+var globalSetProvider = newSetProvider()
+
+// defaultLoader is the merged view every GetX function reads through:
+// explicit Set values, then process env, then .env, then any file or
+// remote providers registered via AddFileProvider/AddRemoteProvider.
+var defaultLoader = NewLoader(globalSetProvider, envProvider{}, newDotenvProvider())
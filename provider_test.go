@@ -0,0 +1,75 @@
+package lib_config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoaderPrecedenceHighestProviderWins(t *testing.T) {
+	set := newSetProvider()
+	set.set("CHUNK0_3_TEST_KEY", "from-set")
+
+	loader := NewLoader(set, envProvider{})
+
+	val, ok := loader.Get("CHUNK0_3_TEST_KEY")
+	if !ok || val != "from-set" {
+		t.Fatalf("Get = (%q, %v), want (%q, true)", val, ok, "from-set")
+	}
+}
+
+func TestLoaderFallsThroughToLowerPriorityProvider(t *testing.T) {
+	os.Setenv("CHUNK0_3_TEST_ENV_ONLY", "from-env")
+	defer os.Unsetenv("CHUNK0_3_TEST_ENV_ONLY")
+
+	loader := NewLoader(newSetProvider(), envProvider{})
+
+	val, ok := loader.Get("CHUNK0_3_TEST_ENV_ONLY")
+	if !ok || val != "from-env" {
+		t.Fatalf("Get = (%q, %v), want (%q, true)", val, ok, "from-env")
+	}
+}
+
+func TestDotenvProviderReadsAndRefreshesOnChange(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".env")
+
+	if err := os.WriteFile(path, []byte("CHUNK0_3_TEST_DOTENV=first\n"), 0644); err != nil {
+		t.Fatalf("failed to write temp .env: %v", err)
+	}
+
+	provider := newDotenvProvider(path)
+
+	val, ok := provider.Get("CHUNK0_3_TEST_DOTENV")
+	if !ok || val != "first" {
+		t.Fatalf("Get = (%q, %v), want (%q, true)", val, ok, "first")
+	}
+
+	// Force the mtime forward so refresh() is guaranteed to pick up the
+	// rewrite regardless of filesystem timestamp resolution.
+	future := time.Now().Add(time.Second)
+	if err := os.WriteFile(path, []byte("CHUNK0_3_TEST_DOTENV=second\n"), 0644); err != nil {
+		t.Fatalf("failed to rewrite temp .env: %v", err)
+	}
+	if err := os.Chtimes(path, future, future); err != nil {
+		t.Fatalf("failed to set mtime: %v", err)
+	}
+
+	val, ok = provider.Get("CHUNK0_3_TEST_DOTENV")
+	if !ok || val != "second" {
+		t.Fatalf("Get after rewrite = (%q, %v), want (%q, true)", val, ok, "second")
+	}
+}
+
+func TestNewFileProviderUnsupportedExtension(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	if err := os.WriteFile(path, []byte("key = \"value\"\n"), 0644); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+
+	if _, err := NewFileProvider(path); err == nil {
+		t.Fatal("expected an error for an unsupported (TOML) config file extension")
+	}
+}
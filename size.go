@@ -0,0 +1,75 @@
+package lib_config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var sizePattern = regexp.MustCompile(`(?i)^([0-9]*\.?[0-9]+)\s*([kmgtp]i?b|b)?$`)
+
+var sizeMultipliers = map[string]float64{
+	"":    1,
+	"b":   1,
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"tb":  1e12,
+	"pb":  1e15,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+	"pib": 1 << 50,
+}
+
+// ParseSize parses a human-readable byte size such as "512", "1.5KiB",
+// "2MB", "10 GiB" or "1.2tb" into an exact byte count. Units are
+// case-insensitive and may be separated from the number by whitespace; SI
+// suffixes (kB, MB, ...) are powers of 1000 and IEC suffixes (KiB, MiB,
+// ...) are powers of 1024. A bare number is treated as a byte count.
+func ParseSize(val string) (int64, error) {
+	val = strings.TrimSpace(val)
+	if val == "" {
+		return 0, fmt.Errorf("lib_config: empty size value")
+	}
+
+	matches := sizePattern.FindStringSubmatch(val)
+	if matches == nil {
+		return 0, fmt.Errorf("lib_config: invalid size %q", val)
+	}
+
+	number, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("lib_config: invalid size %q: %w", val, err)
+	}
+
+	multiplier, ok := sizeMultipliers[strings.ToLower(matches[2])]
+	if !ok {
+		return 0, fmt.Errorf("lib_config: unknown size unit %q", matches[2])
+	}
+
+	return int64(number * multiplier), nil
+}
+
+// GetSizeE looks up key and parses it as a byte size via ParseSize,
+// returning (0, nil) if the variable is unset.
+func GetSizeE(key string) (int64, error) {
+	val, ok := defaultLoader.Get(key)
+	if !ok {
+		return 0, nil
+	}
+	return ParseSize(val)
+}
+
+// GetSize is the error-swallowing variant of GetSizeE kept for
+// compatibility with existing callers: it returns 0 if key is unset or
+// cannot be parsed as a size.
+func GetSize(key string) int64 {
+	size, err := GetSizeE(key)
+	if err != nil {
+		return 0
+	}
+	return size
+}
@@ -0,0 +1,62 @@
+package lib_config
+
+import (
+	"os"
+	"testing"
+)
+
+func TestParseSize(t *testing.T) {
+	cases := []struct {
+		in   string
+		want int64
+	}{
+		{"512", 512},
+		{"1mb", 1_000_000},
+		{"1MiB", 1 << 20},
+		{"1.5KiB", int64(1.5 * (1 << 10))},
+		{"10 GiB", 10 * (1 << 30)},
+		{"1.2tb", int64(1.2 * 1e12)},
+		{"2b", 2},
+	}
+	for _, c := range cases {
+		got, err := ParseSize(c.in)
+		if err != nil {
+			t.Errorf("ParseSize(%q) returned error: %v", c.in, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("ParseSize(%q) = %d, want %d", c.in, got, c.want)
+		}
+	}
+}
+
+func TestParseSizeErrors(t *testing.T) {
+	for _, in := range []string{"", "not-a-size", "5xb"} {
+		if _, err := ParseSize(in); err == nil {
+			t.Errorf("ParseSize(%q) expected an error, got nil", in)
+		}
+	}
+}
+
+func TestGetSizeEAndGetSize(t *testing.T) {
+	os.Setenv("CHUNK0_5_TEST_SIZE", "2MiB")
+	defer os.Unsetenv("CHUNK0_5_TEST_SIZE")
+
+	size, err := GetSizeE("CHUNK0_5_TEST_SIZE")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := int64(2 << 20); size != want {
+		t.Errorf("GetSizeE = %d, want %d", size, want)
+	}
+
+	if GetSize("CHUNK0_5_TEST_SIZE") != size {
+		t.Errorf("GetSize and GetSizeE disagree")
+	}
+
+	os.Setenv("CHUNK0_5_TEST_BAD_SIZE", "not-a-size")
+	defer os.Unsetenv("CHUNK0_5_TEST_BAD_SIZE")
+	if GetSize("CHUNK0_5_TEST_BAD_SIZE") != 0 {
+		t.Errorf("GetSize should swallow parse errors and return 0")
+	}
+}
@@ -0,0 +1,143 @@
+package lib_config
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// watchPollInterval is how often Watch checks .env for changes. Because
+// only one poll fires per interval, writes that land within the same
+// interval are naturally coalesced instead of each triggering a reload.
+const watchPollInterval = time.Second
+
+var (
+	watchMu      sync.Mutex
+	watchStarted bool
+
+	onChangeMu        sync.Mutex
+	onChangeCallbacks = map[string][]func(old, new string){}
+)
+
+// OnChange registers cb to be called whenever Watch observes key change,
+// with the previous and new value. Multiple callbacks may be registered for
+// the same key.
+func OnChange(key string, cb func(old, new string)) {
+	onChangeMu.Lock()
+	defer onChangeMu.Unlock()
+	onChangeCallbacks[key] = append(onChangeCallbacks[key], cb)
+}
+
+// Watch polls the .env file and, whenever its contents differ from the last
+// observed revision, re-runs the same load/validation pipeline setup uses
+// (via the non-exiting applyConfigs): it updates os.Setenv for every changed
+// key, invokes handler with a map of changed keys to their new values, and
+// notifies any OnChange subscribers. This lets a long-running service pick
+// up rotated secrets and tuning knobs without a restart. A reload failure
+// (e.g. a required key dropped mid-edit) is logged rather than exiting the
+// process, so a bad or transient .env edit cannot kill a running service.
+//
+// Watch is safe to call only once per process; a second call returns an
+// error. It returns nil when ctx is cancelled.
+func Watch(ctx context.Context, handler func(changed map[string]string)) error {
+	watchMu.Lock()
+	if watchStarted {
+		watchMu.Unlock()
+		return fmt.Errorf("lib_config: Watch has already been started")
+	}
+	watchStarted = true
+	watchMu.Unlock()
+
+	lastHash, err := hashFile(".env")
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			hash, err := hashFile(".env")
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return err
+			}
+			if hash == lastHash {
+				continue
+			}
+
+			configs := getLoadedConfigs()
+			before := snapshotEnv(configs)
+			if failures := applyConfigs(configs); len(failures) > 0 {
+				for _, failure := range failures {
+					slog.Error("lib_config: Watch failed to apply reloaded .env", "error", failure)
+				}
+			}
+			after := snapshotEnv(configs)
+			changed := diffEnv(before, after)
+
+			// setup may itself have rewritten .env (e.g. to normalise
+			// formatting); re-hash now so that write isn't mistaken for an
+			// external change on the next poll.
+			lastHash, err = hashFile(".env")
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+
+			if len(changed) == 0 {
+				continue
+			}
+			if handler != nil {
+				handler(changed)
+			}
+			notifyOnChange(before, changed)
+		}
+	}
+}
+
+func snapshotEnv(configs []config) map[string]string {
+	snapshot := make(map[string]string, len(configs))
+	for _, c := range configs {
+		snapshot[c.Key] = os.Getenv(c.Key)
+	}
+	return snapshot
+}
+
+func diffEnv(before, after map[string]string) map[string]string {
+	changed := map[string]string{}
+	for key, newVal := range after {
+		if before[key] != newVal {
+			changed[key] = newVal
+		}
+	}
+	return changed
+}
+
+func notifyOnChange(before, changed map[string]string) {
+	onChangeMu.Lock()
+	defer onChangeMu.Unlock()
+	for key, newVal := range changed {
+		for _, cb := range onChangeCallbacks[key] {
+			cb(before[key], newVal)
+		}
+	}
+}
+
+func hashFile(filename string) (string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return string(sum[:]), nil
+}
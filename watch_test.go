@@ -0,0 +1,52 @@
+package lib_config
+
+import (
+	"os"
+	"testing"
+)
+
+// TestApplyConfigsReportsRequiredFailureWithoutExiting exercises the path
+// Watch relies on for a reload triggered by a dropped required key: it must
+// get a failure message back, not have the process terminated underneath
+// it.
+func TestApplyConfigsReportsRequiredFailureWithoutExiting(t *testing.T) {
+	chdirTemp(t)
+	os.Unsetenv("CHUNK0_6_TEST_REQUIRED")
+
+	failures := applyConfigs([]config{
+		{Key: "CHUNK0_6_TEST_REQUIRED", IsRequired: true},
+	})
+
+	if len(failures) == 0 {
+		t.Fatal("expected applyConfigs to report the missing required key, got no failures")
+	}
+}
+
+func TestDiffEnvOnlyReportsChangedKeys(t *testing.T) {
+	before := map[string]string{"A": "1", "B": "2"}
+	after := map[string]string{"A": "1", "B": "3"}
+
+	changed := diffEnv(before, after)
+	if len(changed) != 1 {
+		t.Fatalf("expected exactly one changed key, got %v", changed)
+	}
+	if changed["B"] != "3" {
+		t.Fatalf("expected B to be reported as changed to 3, got %v", changed)
+	}
+}
+
+func TestNotifyOnChangeInvokesRegisteredCallback(t *testing.T) {
+	var gotOld, gotNew string
+	OnChange("CHUNK0_6_TEST_KEY", func(old, new string) {
+		gotOld, gotNew = old, new
+	})
+
+	notifyOnChange(
+		map[string]string{"CHUNK0_6_TEST_KEY": "old-value"},
+		map[string]string{"CHUNK0_6_TEST_KEY": "new-value"},
+	)
+
+	if gotOld != "old-value" || gotNew != "new-value" {
+		t.Fatalf("callback got (%q, %q), want (%q, %q)", gotOld, gotNew, "old-value", "new-value")
+	}
+}